@@ -0,0 +1,124 @@
+package staticsyncpool
+
+import "math/bits"
+
+// BucketedPool is a size-class pool for values whose capacity varies at runtime, such as
+// `*bytes.Buffer` or `[]byte`. Pooling such values in a single `Pool[T]` pins unbounded memory,
+// since `Pool[T].Get` returns an arbitrary element regardless of its capacity. BucketedPool
+// instead maintains one `Pool[*T]` per power-of-two size bucket: `Get` returns an item from the
+// smallest bucket that can satisfy the requested size, and `Put` routes an item back into the
+// bucket matching its own size, discarding items that exceed the largest configured bucket so
+// the pool cannot be poisoned by a one-off giant allocation.
+type BucketedPool[T any] struct {
+	sizeOf  func(*T) int
+	minLog2 int
+	maxLog2 int
+	buckets []*Pool[*T]
+}
+
+// NewBucketed initializes a new BucketedPool[T], following the same `newFunc`/`resetFunc`
+// convention as `New`.
+// `newFunc` is called with a bucket's capacity (always a power of two) whenever that bucket is
+// empty. `resetFunc` resets an item before it's returned to its bucket. `sizeOf` reports an
+// item's current capacity, which `Put` uses to route it to the matching bucket.
+// The bucket range defaults to 2^6 through 2^20 and can be adjusted with `WithBuckets`. All
+// other options (`WithStaticSize`, `WithLazy`, `WithStrictSize`, etc.) are applied independently
+// to every bucket's underlying `Pool[*T]`, so each bucket honors its own static size and lazy
+// configuration rather than sharing state with the others.
+// Combined with `WithStrictSize(true)` and `WithLazy(false)`, every bucket inherits the same
+// hazard `Pool[T]` itself has (see `WithStrictSize`): call `BucketedPool.Close` before dropping
+// such a pool, or the Go runtime will panic the whole process the next time it GCs a bucket's
+// `Pinner` with outstanding pins.
+func NewBucketed[T any](newFunc func(capacity int) *T, resetFunc func(*T), sizeOf func(*T) int, opts ...Option) *BucketedPool[T] {
+	config := defaultConfig()
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
+	// maxLog2 is a hard upper bound, but minLog2 is a guaranteed lower bound, so raise maxLog2
+	// if WithBuckets was configured with a maxLog2 smaller than minLog2, the same way
+	// WithMaxSize's maxSize is raised to StaticSize when it's configured too small.
+	if config.maxLog2 < config.minLog2 {
+		config.maxLog2 = config.minLog2
+	}
+
+	bp := &BucketedPool[T]{
+		sizeOf:  sizeOf,
+		minLog2: config.minLog2,
+		maxLog2: config.maxLog2,
+	}
+
+	bp.buckets = make([]*Pool[*T], bp.maxLog2-bp.minLog2+1)
+	for i := range bp.buckets {
+		bucketCap := 1 << (bp.minLog2 + i)
+		bp.buckets[i] = newPool(
+			func() *T { return newFunc(bucketCap) },
+			resetFunc,
+			opts...,
+		)
+	}
+
+	return bp
+}
+
+// bucketIndex returns the index of the smallest bucket with a capacity >= size, and false if
+// size exceeds the largest configured bucket.
+func (bp *BucketedPool[T]) bucketIndex(size int) (int, bool) {
+	log2 := bp.minLog2
+	if size > 1 {
+		log2 = bits.Len(uint(size - 1))
+	}
+
+	if log2 < bp.minLog2 {
+		log2 = bp.minLog2
+	}
+
+	if log2 > bp.maxLog2 {
+		return 0, false
+	}
+
+	return log2 - bp.minLog2, true
+}
+
+// Get returns an item from the smallest bucket whose capacity is >= sizeHint, allocating via
+// `newFunc(bucketCap)` if that bucket is empty. If sizeHint exceeds the largest configured
+// bucket, the largest bucket is used instead.
+func (bp *BucketedPool[T]) Get(sizeHint int) *T {
+	idx, ok := bp.bucketIndex(sizeHint)
+	if !ok {
+		idx = len(bp.buckets) - 1
+	}
+
+	return bp.buckets[idx].Get()
+}
+
+// Put returns an item to the bucket matching its current size, as reported by `sizeOf`.
+// If the item's size exceeds the largest configured bucket, it is discarded instead of being
+// pooled, so a single oversized item can't pin unbounded memory.
+func (bp *BucketedPool[T]) Put(item *T) {
+	idx, ok := bp.bucketIndex(bp.sizeOf(item))
+	if !ok {
+		return
+	}
+
+	bp.buckets[idx].Put(item)
+}
+
+// Reset resets every bucket's underlying `Pool[*T]`, the same as calling `Reset` on each bucket
+// individually. See `Pool[T].Reset` for the full behavior and caveats.
+func (bp *BucketedPool[T]) Reset() {
+	for _, bucket := range bp.buckets {
+		bucket.Reset()
+	}
+}
+
+// Close closes every bucket's underlying `Pool[*T]`, the same as calling `Close` on each bucket
+// individually. See `Pool[T].Close` for the full behavior and caveats. Call this before letting a
+// `BucketedPool` go out of scope if it was ever configured with both `WithStrictSize(true)` and
+// `WithLazy(false)`, since the buckets are unexported and otherwise unreachable for closing
+// individually.
+func (bp *BucketedPool[T]) Close() {
+	for _, bucket := range bp.buckets {
+		bucket.Close()
+	}
+}
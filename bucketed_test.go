@@ -0,0 +1,102 @@
+package staticsyncpool
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestBucketedPoolRoutesBySize(t *testing.T) {
+	pool := NewBucketed[[]byte](
+		func(capacity int) *[]byte {
+			b := make([]byte, 0, capacity)
+			return &b
+		},
+		func(b *[]byte) {
+			*b = (*b)[:0]
+		},
+		func(b *[]byte) int {
+			return cap(*b)
+		},
+		WithBuckets(6, 10),
+		WithStaticSize(2),
+	)
+
+	small := pool.Get(32)
+	if cap(*small) != 1<<6 {
+		t.Fatalf("expected smallest bucket (capacity %d) for a sizeHint below it, got capacity %d", 1<<6, cap(*small))
+	}
+
+	mid := pool.Get(200)
+	if cap(*mid) != 1<<8 {
+		t.Fatalf("expected bucket with capacity %d for sizeHint 200, got capacity %d", 1<<8, cap(*mid))
+	}
+
+	oversized := pool.Get(1 << 20)
+	if cap(*oversized) != 1<<10 {
+		t.Fatalf("expected largest bucket (capacity %d) for a sizeHint above it, got capacity %d", 1<<10, cap(*oversized))
+	}
+
+	pool.Put(mid)
+	reused := pool.Get(200)
+	if cap(*reused) != 1<<8 {
+		t.Fatalf("expected reused item to come from the same bucket (capacity %d), got capacity %d", 1<<8, cap(*reused))
+	}
+
+	// An item larger than the largest bucket should be silently discarded, not pooled.
+	giant := make([]byte, 0, 1<<20)
+	pool.Put(&giant)
+
+	pool.Reset()
+}
+
+func TestBucketedPoolClampsInvertedBuckets(t *testing.T) {
+	pool := NewBucketed[[]byte](
+		func(capacity int) *[]byte {
+			b := make([]byte, 0, capacity)
+			return &b
+		},
+		func(b *[]byte) {
+			*b = (*b)[:0]
+		},
+		func(b *[]byte) int {
+			return cap(*b)
+		},
+		WithBuckets(10, 6),
+	)
+
+	item := pool.Get(32)
+	if cap(*item) != 1<<10 {
+		t.Fatalf("expected WithBuckets(10, 6) to clamp to a single bucket of capacity %d, got capacity %d", 1<<10, cap(*item))
+	}
+
+	pool.Reset()
+}
+
+func TestBucketedPoolCloseUnpinsStrictEagerBuckets(t *testing.T) {
+	pool := NewBucketed[[]byte](
+		func(capacity int) *[]byte {
+			b := make([]byte, 0, capacity)
+			return &b
+		},
+		func(b *[]byte) {
+			*b = (*b)[:0]
+		},
+		func(b *[]byte) int {
+			return cap(*b)
+		},
+		WithBuckets(6, 10),
+		WithStaticSize(2),
+		WithLazy(false),
+		WithStrictSize(true),
+	)
+
+	// Every bucket's underlying Pool[*T] is strict+eager, so it keeps items pinned across
+	// Reset() (see Pool[T].Close). Without Close, dropping the pool and forcing a GC would panic
+	// the whole process with "runtime.Pinner: found leaking pinned pointer".
+	pool.Close()
+
+	pool = nil
+	runtime.GC()
+	runtime.GC()
+	runtime.GC()
+}
@@ -1,17 +1,45 @@
 package staticsyncpool
 
+import "time"
+
 type config struct {
-	staticSize int
-	lazy       bool
+	staticSize     int
+	lazy           bool
+	strict         bool
+	maxSize        int
+	victimCache    bool
+	minLog2        int
+	maxLog2        int
+	observer       func(PoolEvent)
+	sharded        bool
+	shardCount     int
+	acquireTimeout time.Duration
 }
 
 func defaultConfig() *config {
 	return &config{
-		staticSize: 100,
-		lazy:       false,
+		staticSize:     100,
+		lazy:           false,
+		strict:         false,
+		maxSize:        0,
+		victimCache:    false,
+		minLog2:        defaultMinLog2,
+		maxLog2:        defaultMaxLog2,
+		observer:       nil,
+		sharded:        false,
+		shardCount:     0,
+		acquireTimeout: 0,
 	}
 }
 
+// Default bucket range for `BucketedPool[T]`, used when `WithBuckets` is not configured:
+// 2^6 (64 bytes) through 2^20 (1 MiB), which comfortably covers the common `[]byte`/
+// `*bytes.Buffer` pooling use case without configuration.
+const (
+	defaultMinLog2 = 6
+	defaultMaxLog2 = 20
+)
+
 type Option interface {
 	apply(*config)
 }
@@ -61,3 +89,114 @@ func WithLazy(lazy bool) Option {
 		c.lazy = lazy
 	})
 }
+
+// WithStrictSize configures whether the pool enforces a true hard upper bound on the number
+// of statically held items, instead of the default best-effort accounting.
+// When strict is true, `StaticSize` becomes a guaranteed lower bound and `MaxSize` (configured
+// via `WithMaxSize`) becomes a hard upper bound: the pool is backed by a bounded buffered channel
+// of pinned items layered in front of the underlying `sync.Pool`, so `Get`/`Put` never race on a
+// shared counter to decide whether to pin a value.
+// If `WithMaxSize` is not also configured (or is configured smaller than `StaticSize`), `MaxSize`
+// defaults to `StaticSize`.
+// This trades the lazy mode's "may overshoot `StaticSize`" behavior for deterministic bounds, at
+// the cost of an extra non-blocking channel operation on the hot path.
+// Combined with `WithLazy(false)`, this has a hazard it shares only with `WithVictimCache(true)`:
+// the eager fill is redone on every `Reset()` rather than only once (see `Reset`), so
+// the pool still holds pinned items immediately after its last `Reset()`. Call `Close` before
+// dropping such a pool, or the Go runtime will panic the whole process the next time it GCs a
+// `Pinner` that still has outstanding pins.
+func WithStrictSize(strict bool) Option {
+	return newOption(func(c *config) {
+		c.strict = strict
+	})
+}
+
+// WithMaxSize configures the hard upper bound on the number of statically held (pinned) items
+// when the pool is configured with `WithStrictSize(true)`. It has no effect otherwise.
+// If `maxSize` is smaller than the configured `StaticSize`, it is raised to `StaticSize`, since
+// `StaticSize` is guaranteed to be a lower bound under strict mode.
+func WithMaxSize(maxSize int) Option {
+	return newOption(func(c *config) {
+		c.maxSize = maxSize
+	})
+}
+
+// WithVictimCache configures whether the pool keeps a secondary "victim" generation of pinned
+// items around across a `Reset()`, modeled after Go 1.13's sync.Pool victim-cache design.
+// Instead of unpinning the currently pinned items immediately, `Reset()` demotes them to the
+// victim generation; `Get` then falls back to draining the victim generation (before calling
+// `newFunc`) whenever the main pool has nothing to offer. The victim generation itself is only
+// unpinned and dropped on the following `Reset()` (or an explicit call to `Drain()`), giving
+// callers two-generation survivability instead of a cold-start cliff immediately after `Reset()`.
+// This roughly doubles the pool's peak pinned memory, so it is opt-in.
+// WithVictimCache only affects the default (non-strict) pinning path; it has no effect when
+// combined with `WithStrictSize(true)`, since an item drained from the victim generation carries
+// no record of having already been pinned there, which would otherwise let strict mode's hard
+// `maxSize` cap be silently broken by re-pinning the same item a second time.
+func WithVictimCache(victimCache bool) Option {
+	return newOption(func(c *config) {
+		c.victimCache = victimCache
+	})
+}
+
+// WithBuckets configures the power-of-two size-class range used by `BucketedPool[T]`.
+// `minLog2` and `maxLog2` are the base-2 logarithms of the smallest and largest bucket
+// capacities respectively, e.g. `WithBuckets(10, 16)` configures buckets of 1KiB through 64KiB.
+// It has no effect on a plain `Pool[T]`.
+func WithBuckets(minLog2, maxLog2 int) Option {
+	return newOption(func(c *config) {
+		c.minLog2 = minLog2
+		c.maxLog2 = maxLog2
+	})
+}
+
+// WithObserver registers a function to be called whenever the pool emits a `PoolEvent`, allowing
+// callers to plumb pool lifecycle events into Prometheus/OpenTelemetry/etc without this module
+// taking a hard dependency on any of them. The observer is only invoked on lifecycle events
+// (`Reset`, a strict-mode overflow, first-fill-complete, and a `GetContext` rejection) rather
+// than on every `Get`/`Put`, so it does not affect hot-path allocation behavior. For cumulative
+// counters that can be read on demand instead, see `Stats`.
+func WithObserver(observer func(event PoolEvent)) Option {
+	return newOption(func(c *config) {
+		c.observer = observer
+	})
+}
+
+// WithSharded configures whether the pool spreads its lazy-mode pinning bookkeeping (the
+// `lazySize` counter and the `runtime.Pinner`) across multiple independent shards, instead of
+// serializing every lazy `Get` through a single shared counter and pinner. Shard selection
+// itself still goes through one shared atomic round-robin counter (see `shardFor`), so this
+// doesn't eliminate contention outright; it shrinks the work done under that single point of
+// contention down to choosing a shard index, while the comparatively more expensive counter
+// increment and `Pinner.Pin` call are the part that's actually spread out. `StaticSize` is
+// distributed across shards as evenly as possible (each shard gets `StaticSize / shardCount`,
+// with the remainder handed out one-per-shard), so the shards' targets always sum to exactly
+// `StaticSize` regardless of how `StaticSize` and `shardCount` compare.
+// WithSharded only affects the default (non-strict) pinning path, including the eager fill done
+// for `WithLazy(false)`; it has no effect when
+// combined with `WithStrictSize(true)` or `WithVictimCache(true)`, both of which already manage
+// their own pinning bookkeeping.
+func WithSharded(sharded bool) Option {
+	return newOption(func(c *config) {
+		c.sharded = sharded
+	})
+}
+
+// WithShardCount configures the number of shards used when the pool is configured with
+// `WithSharded(true)`. It has no effect otherwise. If left at 0 (the default), the pool uses
+// `runtime.GOMAXPROCS(0)` shards.
+func WithShardCount(shardCount int) Option {
+	return newOption(func(c *config) {
+		c.shardCount = shardCount
+	})
+}
+
+// WithAcquireTimeout configures how long `GetContext` will wait for an item to become available
+// under `WithStrictSize(true)` before giving up with `ErrPoolExhausted`, independent of the
+// passed-in `context.Context`. It has no effect otherwise. If left at 0 (the default),
+// `GetContext` waits however long the passed-in `context.Context` allows, which may be forever.
+func WithAcquireTimeout(d time.Duration) Option {
+	return newOption(func(c *config) {
+		c.acquireTimeout = d
+	})
+}
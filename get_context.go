@@ -0,0 +1,81 @@
+package staticsyncpool
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPoolExhausted is returned by GetContext when the pool is configured with
+// `WithStrictSize(true)` and `WithAcquireTimeout`, and no item became available before the
+// acquire timeout elapsed.
+var ErrPoolExhausted = errors.New("staticsyncpool: pool exhausted, acquire timed out")
+
+// GetContext returns an item from the pool the same way Get does, except that when the pool is
+// configured with `WithStrictSize(true)` and the bounded, pinned channel is empty, it first tries
+// to grow the pool's live item count toward `WithMaxSize` by allocating and pinning a new item,
+// and only waits for another goroutine to `Put` an item back once that cap is reached, instead of
+// falling back to `newFunc`. This turns `Pool[T]` into a connection-pool-style primitive for
+// callers for whom unbounded allocation under load is worse than waiting: a cap configured via
+// `WithMaxSize` becomes an actual limit on concurrently-live items rather than just a limit on how
+// many of them stay pinned.
+//
+// GetContext gives up and returns an error in one of two ways: if `ctx` is cancelled, it returns
+// `ctx.Err()`; if `WithAcquireTimeout` was configured and that duration elapses first, it returns
+// `ErrPoolExhausted`. Either way, this is counted in `Stats().Rejections` and notifies the
+// observer with `PoolEventRejection`.
+//
+// If the pool was not configured with `WithStrictSize(true)`, GetContext behaves exactly like
+// Get, always succeeds, and never blocks - there is no hard cap to wait on.
+//
+// If the pool is currently closed (by a concurrent `Reset`), GetContext falls back to `newFunc`
+// directly, the same as Get, instead of touching the bounded channel.
+func (p *Pool[T]) GetContext(ctx context.Context) (T, error) {
+	if !p.config.strict {
+		return p.Get(), nil
+	}
+
+	p.gets.Add(1)
+
+	if p.closed.Load() {
+		p.news.Add(1)
+		return p.newFunc(), nil
+	}
+
+	select {
+	case item := <-p.strictCh:
+		return item, nil
+	default:
+	}
+
+	if item, ok := p.growStrict(); ok {
+		return item, nil
+	}
+
+	var timeoutC <-chan time.Time
+	if p.config.acquireTimeout > 0 {
+		timer := time.NewTimer(p.config.acquireTimeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+
+	select {
+	case item := <-p.strictCh:
+		return item, nil
+	case <-ctx.Done():
+		p.reject()
+		var zero T
+		return zero, ctx.Err()
+	case <-timeoutC:
+		p.reject()
+		var zero T
+		return zero, ErrPoolExhausted
+	}
+}
+
+// reject records a GetContext acquire that gave up waiting, via the rejections counter and the
+// observer's PoolEventRejection event.
+func (p *Pool[T]) reject() {
+	p.rejections.Add(1)
+	p.notify(PoolEvent{Kind: PoolEventRejection})
+}
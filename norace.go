@@ -0,0 +1,6 @@
+//go:build !race
+
+package staticsyncpool
+
+// RaceEnabled reports whether the binary was built with the race detector. See race.go.
+const RaceEnabled = false
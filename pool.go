@@ -17,6 +17,75 @@ type Pool[T any] struct {
 	internalPool *sync.Pool
 	lazySize     atomic.Int64
 	closed       atomic.Bool
+	// strictCh is only allocated when the pool is configured with `WithStrictSize(true)`.
+	// It holds pinned items and acts as a hard-capped layer in front of `internalPool`.
+	strictCh chan T
+	// strictPinnedMu guards strictPinned, which records the identity of every item that has
+	// already been pinned via strictCh, so that `Put` only pins an item the first time it
+	// enters the channel rather than re-pinning it on every cycle (runtime.Pinner has no
+	// per-object Unpin, so re-pinning the same item repeatedly would otherwise grow its
+	// internal bookkeeping without bound).
+	strictPinnedMu *sync.Mutex
+	strictPinned   map[any]struct{}
+	// strictEager records whether the pool was originally configured with `WithLazy(false)` at
+	// construction time, independent of `p.config.lazy`, which `Reset` unconditionally forces to
+	// true. This is what lets a strict pool's eager fill survive a `Reset()` instead of silently
+	// only ever happening once.
+	strictEager bool
+
+	// The following fields are only used when the pool is configured with
+	// `WithVictimCache(true)`. pinnedMu guards pinnedItems and pinnedSet, which track every item
+	// pinned during the current generation so it can be handed off to the victim generation on
+	// the next Reset(). victimMu guards victim, victimPinner, and victimSet, which together track
+	// the previous generation. pinnedSet and victimSet exist alongside pinnedItems/victim (rather
+	// than relying on the slices alone) so that `alreadyPinned` can cheaply recognize an item that
+	// resurfaces via an `internalPool` hit after a `Reset()` as already pinned via a still-
+	// outstanding `victimPinner`, instead of pinning it a second time via the new generation's
+	// pinner (internalPool itself isn't cleared on Reset, so such a hit is routine, not rare).
+	pinnedMu     *sync.Mutex
+	pinnedItems  []T
+	pinnedSet    map[any]struct{}
+	victimMu     *sync.Mutex
+	victim       []T
+	victimSet    map[any]struct{}
+	victimPinner *runtime.Pinner
+	// victimPinnedCount is the number of items that were pinned into the victim generation when
+	// it was created, so that unpinning it decrements pinnedCount by the right amount even after
+	// some of its items have already been drained out by Get.
+	victimPinnedCount int64
+
+	// Runtime counters, read via Stats(). Always maintained, regardless of configuration.
+	gets        atomic.Int64
+	puts        atomic.Int64
+	news        atomic.Int64
+	pinnedCount atomic.Int64
+	resets      atomic.Int64
+	overflows   atomic.Int64
+	rejections  atomic.Int64
+
+	// shards is only allocated when the pool is configured with `WithSharded(true)` (and
+	// neither `WithStrictSize(true)` nor `WithVictimCache(true)`). shardCursor is the round-robin
+	// counter used by `shardFor` to pick a shard.
+	shards      []*shard
+	shardCursor atomic.Uint64
+}
+
+// sharded reports whether this pool should use the sharded lazy-pinning path. Sharding is only
+// meaningful for the default (non-strict) lazy-pinning path, so it's disabled outright when
+// strict or victim-cache mode is also configured, both of which already manage their own pinning.
+func (p *Pool[T]) sharded() bool {
+	return p.config.sharded && !p.config.strict && !p.config.victimCache
+}
+
+// victimCacheEnabled reports whether this pool should use the victim-cache tier. The victim cache
+// is only meaningful for the default (non-strict) pinning path: strict mode already enforces its
+// own hard cap via growStrict/pinIfStrictUnpinned, and an item drained from the victim generation
+// carries no record of having already been pinned there, so strict mode's Put would re-pin it a
+// second time via the current generation's pinner, permanently inflating PinnedCount past the
+// configured maxSize. So, just like sharded(), victim-cache mode is disabled outright when strict
+// mode is also configured.
+func (p *Pool[T]) victimCacheEnabled() bool {
+	return p.config.victimCache && !p.config.strict
 }
 
 // New will initialize a new Pool with the given `newFunc` for initializing new values,
@@ -25,24 +94,37 @@ type Pool[T any] struct {
 // The default config sets the Static Size to 100 and the Lazy setting to false.
 // These can be adjusted using `WithStaticSize` and `WithLazy`.
 func New[T any](newFunc func() *T, resetFunc func(*T), opts ...Option) *Pool[*T] {
+	return newPool(newFunc, resetFunc, opts...)
+}
+
+// newPool is the shared constructor behind `New`. It is split out, and kept generic over `T`
+// itself (rather than forcing the `func() *T` / `Pool[*T]` convention `New` exposes), so that
+// other types in this package can build their own `Pool[T]` instances directly;
+// `BucketedPool[T]` uses it to build one `Pool[*T]` per bucket.
+func newPool[T any](newFunc func() T, resetFunc func(T), opts ...Option) *Pool[T] {
 	config := defaultConfig()
 	for _, opt := range opts {
 		opt.apply(config)
 	}
 
-	p := &Pool[*T]{
-		resetMu:   &sync.Mutex{},
-		config:    config,
-		newFunc:   newFunc,
-		resetFunc: resetFunc,
-		pinner:    &runtime.Pinner{},
-		lazySize:  atomic.Int64{},
-		closed:    atomic.Bool{},
-		internalPool: &sync.Pool{
-			New: func() any {
-				return newFunc()
-			},
-		},
+	p := &Pool[T]{
+		resetMu:        &sync.Mutex{},
+		config:         config,
+		newFunc:        newFunc,
+		resetFunc:      resetFunc,
+		pinner:         &runtime.Pinner{},
+		lazySize:       atomic.Int64{},
+		closed:         atomic.Bool{},
+		pinnedMu:       &sync.Mutex{},
+		pinnedSet:      make(map[any]struct{}),
+		victimMu:       &sync.Mutex{},
+		strictPinnedMu: &sync.Mutex{},
+		internalPool:   &sync.Pool{},
+		strictEager:    !config.lazy,
+	}
+
+	if p.sharded() {
+		p.shards = newShards(config.shardCount, config.staticSize)
 	}
 
 	p.init()
@@ -50,22 +132,210 @@ func New[T any](newFunc func() *T, resetFunc func(*T), opts ...Option) *Pool[*T]
 	return p
 }
 
+// pin pins an item via the pool's current-generation pinner, and, if the pool is configured
+// with `WithVictimCache(true)`, also records it so it can be handed off to the victim
+// generation on the next Reset().
+func (p *Pool[T]) pin(item T) {
+	p.pinner.Pin(item)
+	p.pinnedCount.Add(1)
+
+	if !p.victimCacheEnabled() {
+		return
+	}
+
+	p.pinnedMu.Lock()
+	p.pinnedItems = append(p.pinnedItems, item)
+	p.pinnedSet[any(item)] = struct{}{}
+	p.pinnedMu.Unlock()
+}
+
+// alreadyPinned reports whether item is already pinned, either in the current generation or
+// (when victim-cache is enabled) the victim generation left over from the previous Reset(). Only
+// meaningful under WithVictimCache(true): it's what lets the lazy-fill path in Get recognize an
+// item that resurfaces via an internalPool hit shortly after a Reset() as already pinned via the
+// still-outstanding victimPinner, rather than pinning it a second time via the new generation's
+// pinner.
+func (p *Pool[T]) alreadyPinned(item T) bool {
+	if !p.victimCacheEnabled() {
+		return false
+	}
+
+	p.pinnedMu.Lock()
+	_, inCurrent := p.pinnedSet[any(item)]
+	p.pinnedMu.Unlock()
+	if inCurrent {
+		return true
+	}
+
+	p.victimMu.Lock()
+	_, inVictim := p.victimSet[any(item)]
+	p.victimMu.Unlock()
+
+	return inVictim
+}
+
+// pinItem pins an item for the eager-fill path, routing to a shard when sharding is enabled,
+// and to the regular (possibly victim-cache-tracked) pinner otherwise.
+func (p *Pool[T]) pinItem(item T) {
+	if p.sharded() {
+		sh := p.shardFor()
+		sh.pinner.Pin(item)
+		sh.lazySize.Add(1)
+		p.pinnedCount.Add(1)
+		return
+	}
+
+	p.pin(item)
+}
+
+// strictMaxSize returns the effective hard upper bound for a strict-mode pool: maxSize is a hard
+// upper bound, but staticSize is a guaranteed lower bound, so this raises maxSize if it was
+// configured smaller than (or left at) the default.
+func (p *Pool[T]) strictMaxSize() int {
+	maxSize := p.config.maxSize
+	if maxSize < p.config.staticSize {
+		maxSize = p.config.staticSize
+	}
+
+	return maxSize
+}
+
+// markStrictPinned records that item has already been pinned via strictCh, so a later Put
+// knows not to pin it again. Used for items pinned during the eager fill in init(), which are
+// known not to be in strictPinned yet.
+func (p *Pool[T]) markStrictPinned(item T) {
+	p.strictPinnedMu.Lock()
+	p.strictPinned[any(item)] = struct{}{}
+	p.strictPinnedMu.Unlock()
+}
+
+// growStrict allocates and pins a new item for the strict-mode pool, unless strictMaxSize items
+// have already been pinned in the current generation, in which case it reports false instead of
+// growing past the configured hard cap. This is what lets GetContext's live item count actually
+// grow from staticSize up toward maxSize, instead of only ever handing out the items created at
+// fill time.
+func (p *Pool[T]) growStrict() (T, bool) {
+	item := p.newFunc()
+
+	p.strictPinnedMu.Lock()
+	if len(p.strictPinned) >= p.strictMaxSize() {
+		p.strictPinnedMu.Unlock()
+		var zero T
+		return zero, false
+	}
+	p.strictPinned[any(item)] = struct{}{}
+	p.strictPinnedMu.Unlock()
+
+	p.pin(item)
+
+	return item, true
+}
+
+// pinIfStrictUnpinned pins item via strictCh's pinner, unless it has already been pinned, in a
+// single critical section covering both the check and the record, rather than two separate
+// lock acquisitions. Just like `growStrict`, it refuses to pin past `strictMaxSize`, so a caller
+// that repeatedly `Put`s fresh items (rather than ones already handed out by `Get`) can't grow
+// `strictPinned` and its underlying `runtime.Pinner` without bound: the item still goes into
+// `strictCh` so it can be handed back out by `Get`, it's just handed back out unpinned.
+func (p *Pool[T]) pinIfStrictUnpinned(item T) {
+	p.strictPinnedMu.Lock()
+	_, alreadyPinned := p.strictPinned[any(item)]
+	if !alreadyPinned && len(p.strictPinned) >= p.strictMaxSize() {
+		p.strictPinnedMu.Unlock()
+		return
+	}
+	if !alreadyPinned {
+		p.strictPinned[any(item)] = struct{}{}
+	}
+	p.strictPinnedMu.Unlock()
+
+	if !alreadyPinned {
+		p.pin(item)
+	}
+}
+
+// drainVictim pops a single item off of the victim generation, if one is available.
+// The item is removed from the victim generation's tracking slice; its underlying pin is
+// only released in bulk, alongside the rest of the generation, on the next Reset() or Drain().
+func (p *Pool[T]) drainVictim() (T, bool) {
+	p.victimMu.Lock()
+	defer p.victimMu.Unlock()
+
+	if len(p.victim) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	last := len(p.victim) - 1
+	item := p.victim[last]
+	p.victim = p.victim[:last]
+
+	return item, true
+}
+
 func (p *Pool[T]) init() {
 	// Set the lazy size to 0 since this is an initialization...
 	// we should have 0 values in the size
 	p.lazySize.Store(0)
 
+	if p.config.strict {
+		maxSize := p.strictMaxSize()
+
+		// Reuse the existing channel and map across Reset() instead of reallocating them: a
+		// GetContext call that's already blocked reading from the old p.strictCh (e.g. because
+		// it raced a concurrent Reset()) would otherwise be left waiting on a channel nothing
+		// will ever send to again.
+		if p.strictCh == nil {
+			p.strictCh = make(chan T, maxSize)
+		} else {
+		drain:
+			for {
+				select {
+				case <-p.strictCh:
+				default:
+					break drain
+				}
+			}
+		}
+
+		p.strictPinnedMu.Lock()
+		if p.strictPinned == nil {
+			p.strictPinned = make(map[any]struct{}, maxSize)
+		} else {
+			for item := range p.strictPinned {
+				delete(p.strictPinned, item)
+			}
+		}
+		p.strictPinnedMu.Unlock()
+
+		if !p.strictEager {
+			return
+		}
+
+		for i := 0; i < p.config.staticSize; i++ {
+			item := p.newFunc()
+			p.pin(item)
+			p.markStrictPinned(item)
+			p.strictCh <- item
+		}
+
+		p.notify(PoolEvent{Kind: PoolEventFirstFillComplete})
+
+		return
+	}
+
 	if p.config.lazy {
 		return
 	}
 
 	for i := 0; i < p.config.staticSize; i++ {
 		item := p.newFunc()
-		p.pinner.Pin(item)
+		p.pinItem(item)
 		p.internalPool.Put(item)
 	}
 
 	p.lazySize.Add(int64(p.config.staticSize))
+	p.notify(PoolEvent{Kind: PoolEventFirstFillComplete})
 }
 
 // Get will return an item from the Pool.
@@ -75,17 +345,70 @@ func (p *Pool[T]) init() {
 // then extra logic is run to determine if the pool needs to add this value to it's
 // static pool. If the pool is currently closed (by the Reset function),
 // then we simply return the result of the configured `newFunc`.
+// If the pool was configured with `WithStrictSize(true)`, `Get` first attempts a non-blocking
+// receive from the bounded, pinned channel before falling back to the behavior above.
+// If the pool was configured with `WithVictimCache(true)` (and not also `WithStrictSize(true)`,
+// which disables the victim cache, see `WithVictimCache`), and `internalPool` has nothing to
+// offer, `Get` drains from the victim generation (left over from the previous `Reset()`) before
+// finally falling back to `newFunc`.
+// If the pool was configured with `WithSharded(true)`, the lazy-pinning bookkeeping below is
+// spread across multiple shards instead of a single shared counter and pinner.
 func (p *Pool[T]) Get() T {
+	p.gets.Add(1)
+
 	if p.closed.Load() {
 		// If the pool is closed, do nothing special, just allocate.
+		p.news.Add(1)
 		return p.newFunc()
 	}
 
-	item := p.internalPool.Get().(T)
+	if p.config.strict {
+		select {
+		case item := <-p.strictCh:
+			return item
+		default:
+		}
+	}
+
+	item, ok := p.internalPool.Get().(T)
+	if !ok {
+		if p.victimCacheEnabled() {
+			if victimItem, ok := p.drainVictim(); ok {
+				return victimItem
+			}
+		}
+
+		// Fall through to the same lazy-pinning logic below as a hit would, instead of
+		// returning immediately: a freshly allocated item is just as eligible to become one of
+		// the pool's static, pinned values as one that happened to already be sitting in
+		// `internalPool`.
+		p.news.Add(1)
+		item = p.newFunc()
+	}
+
+	// If the config is non-lazy, or strict, we've already initialized the pool with a static
+	// amount of pinned values (or pin deterministically in Put), so no need to do checks for
+	// pinning here.
+	if !p.config.lazy || p.config.strict {
+		return item
+	}
+
+	if p.sharded() {
+		sh := p.shardFor()
+		if sh.lazySize.Load() >= sh.target {
+			return item
+		}
+
+		sh.pinner.Pin(item)
+		sh.lazySize.Add(1)
+		// Same equality check as the non-sharded path below: pinnedCount only ever climbs by 1
+		// at a time across the shards (this branch is unreachable once the config is non-lazy or
+		// strict, see above), so it passes through staticSize exactly once, which is what lets
+		// this fire FirstFillComplete exactly once regardless of how many shards there are.
+		if p.pinnedCount.Add(1) == int64(p.config.staticSize) {
+			p.notify(PoolEvent{Kind: PoolEventFirstFillComplete})
+		}
 
-	// If the config is non-lazy, we've already initialized the pool with a static amount of pinned values,
-	// so no need to do checks for pinning.
-	if !p.config.lazy {
 		return item
 	}
 
@@ -95,14 +418,31 @@ func (p *Pool[T]) Get() T {
 		return item
 	}
 
-	p.pinner.Pin(item)
+	// With WithVictimCache(true), internalPool isn't cleared on Reset(), so a hit can still
+	// surface an item pinned via a still-outstanding victimPinner from before the Reset(); pinning
+	// it again here via the new generation's pinner would double-count it. Such an item doesn't
+	// count toward this generation's lazySize either: it isn't actually pinned in this generation,
+	// just incidentally still alive via the victim tier, so letting it satisfy the quota would
+	// leave this generation under-pinned once that victim tier is eventually dropped.
+	if p.alreadyPinned(item) {
+		return item
+	}
+
+	p.pin(item)
 	// Racy-ish... we can potentially create too many without doing a CAS.
 	// But, doing a CAS could also be potentially heavy... depending on hot-path and everything.
 	// Docs should note that when `WithLazy` is set to `true`, the pool can become semi-leaky...
 	// meaning we may create more items than the static size configuration sets.
 	// `WithStaticSize` defines a minimum number of static elements, however because this isn't being done with CAS
 	// then it's possible to pass it.
-	p.lazySize.Add(1)
+	// If this racy behavior is undesirable, use `WithStrictSize(true)` instead, which enforces a
+	// deterministic, non-racy bound via a bounded channel rather than a CAS-free counter.
+	// Alternatively, `WithSharded(true)` spreads this same racy-by-design counter across multiple
+	// independent shards, trading a small amount of additional overshoot for far less contention.
+	newLazySize := p.lazySize.Add(1)
+	if newLazySize == int64(p.config.staticSize) {
+		p.notify(PoolEvent{Kind: PoolEventFirstFillComplete})
+	}
 
 	return item
 }
@@ -110,8 +450,30 @@ func (p *Pool[T]) Get() T {
 // Put will return an item to the pool, to be reused by others.
 // Before returning to the pool, the configured `resetFunc` is run
 // to reset the item for reuse.
+// If the pool was configured with `WithStrictSize(true)`, and the pool is not currently closed
+// (by `Reset`), `Put` first attempts a non-blocking send into the bounded, pinned channel,
+// pinning the item the first time it enters the channel (an item that already went through the
+// channel once, e.g. on a prior `Get`, is not re-pinned, and once the strict max size worth of
+// items is pinned, a new item enters the channel unpinned); if the channel is full, it falls back
+// to `internalPool.Put`, same as the non-strict behavior. If the pool is closed, `Put` skips the
+// strict channel entirely, the same way `Get` skips it, which closes the same race window `Get`
+// already closes (a narrow one remains between the closed check and the channel send, same as
+// in `Get`, since neither holds `resetMu`).
 func (p *Pool[T]) Put(item T) {
+	p.puts.Add(1)
 	p.resetFunc(item)
+
+	if p.config.strict && !p.closed.Load() {
+		select {
+		case p.strictCh <- item:
+			p.pinIfStrictUnpinned(item)
+			return
+		default:
+			p.overflows.Add(1)
+			p.notify(PoolEvent{Kind: PoolEventOverflow})
+		}
+	}
+
 	p.internalPool.Put(item)
 }
 
@@ -124,6 +486,17 @@ func (p *Pool[T]) Put(item T) {
 // all memory is released from the static values and you want your memory usage to decrease.
 // It is recommended that if you have set the lazy configuration to false that you do not reset,
 // since you will lose the performance boost of having your static pool size initialized at startup.
+// This caveat does not apply to a pool configured with `WithStrictSize(true)`: whether it was
+// originally configured eager (`WithLazy(false)`) is tracked independently of the forced-lazy
+// config above, so a strict pool's eager fill is redone on every `Reset()` rather than only once.
+// This also means such a pool still holds pinned items right after its last `Reset()`; call
+// `Close` before dropping it (see `WithStrictSize`).
+// If the pool was configured with `WithVictimCache(true)`, the currently pinned generation is not
+// unpinned immediately. Instead, it is demoted to the victim generation, which `Get` can still
+// drain from, and the previous victim generation (from the Reset() before this one) is the one
+// that finally gets unpinned and dropped.
+// If the pool was configured with `WithSharded(true)`, every shard's pinner is unpinned and its
+// lazy-fill counter reset, same as the single shared pinner/counter in the default case.
 func (p *Pool[T]) Reset() {
 	// Acquire lock. Reset should only run 1 at a time. This is the only function that uses a mutext,
 	// other functions utilize `p.closed` to ensure that they are running without affecting the pinner.
@@ -132,12 +505,110 @@ func (p *Pool[T]) Reset() {
 
 	// Close the pool so that we don't have any leaks in the pinner
 	p.closed.Store(true)
-	// Unpin everything
-	p.pinner.Unpin()
+
+	if p.victimCacheEnabled() {
+		p.demoteToVictim()
+	} else if p.sharded() {
+		for _, sh := range p.shards {
+			sh.pinner.Unpin()
+			sh.lazySize.Store(0)
+		}
+
+		p.pinnedCount.Store(0)
+	} else {
+		// Unpin everything
+		p.pinner.Unpin()
+		p.pinnedCount.Store(0)
+	}
+
 	// Force the config to be lazy after Reset completes.
 	p.config.lazy = true
 	// Initialize the pool from scratch
 	p.init()
 	// Set closed back to false to open the pool back up
 	p.closed.Store(false)
+
+	p.resets.Add(1)
+	p.notify(PoolEvent{Kind: PoolEventReset})
+}
+
+// Close permanently unpins every item the pool currently holds pinned, including any victim
+// generation left over from `WithVictimCache(true)`, and marks the pool closed so a stray `Get`/
+// `Put` afterwards falls back to `newFunc`/`internalPool.Put` rather than touching the
+// (now-unpinned) pinners. The pool must not be used again after `Close`.
+// Call this before letting a pool go out of scope if it was ever configured with both
+// `WithStrictSize(true)` and `WithLazy(false)`, or with `WithVictimCache(true)`: unlike every
+// other configuration, these keep items pinned across every `Reset()`, including the last one
+// before the pool is dropped (the strict eager fill is redone, see `WithStrictSize`, and the
+// victim generation stays pinned until the next `Reset()` or `Drain()`), and Go's runtime panics
+// the whole process if a `Pinner` with outstanding pins is garbage collected without `Unpin()`
+// having been called first.
+func (p *Pool[T]) Close() {
+	p.resetMu.Lock()
+	defer p.resetMu.Unlock()
+
+	p.closed.Store(true)
+
+	if p.sharded() {
+		for _, sh := range p.shards {
+			sh.pinner.Unpin()
+		}
+	} else {
+		p.pinner.Unpin()
+	}
+
+	if p.victimCacheEnabled() {
+		p.victimMu.Lock()
+		if p.victimPinner != nil {
+			p.victimPinner.Unpin()
+		}
+		p.victimMu.Unlock()
+	}
+}
+
+// Drain immediately unpins and discards the victim generation, without waiting for the next
+// Reset() to do so implicitly. It is a no-op if the pool is not configured with
+// `WithVictimCache(true)`, or if there is no victim generation yet.
+func (p *Pool[T]) Drain() {
+	if !p.victimCacheEnabled() {
+		return
+	}
+
+	p.victimMu.Lock()
+	defer p.victimMu.Unlock()
+
+	if p.victimPinner != nil {
+		p.victimPinner.Unpin()
+		p.pinnedCount.Add(-p.victimPinnedCount)
+		p.victimPinner = nil
+		p.victimPinnedCount = 0
+	}
+
+	p.victim = nil
+	p.victimSet = nil
+}
+
+// demoteToVictim unpins the previous victim generation (its two-generation grace period is
+// over), and promotes the currently pinned generation to take its place as the new victim
+// generation, leaving the pool with a fresh, empty pinner for the next generation.
+func (p *Pool[T]) demoteToVictim() {
+	p.pinnedMu.Lock()
+	pinnedItems := p.pinnedItems
+	pinnedSet := p.pinnedSet
+	p.pinnedItems = nil
+	p.pinnedSet = make(map[any]struct{})
+	p.pinnedMu.Unlock()
+
+	p.victimMu.Lock()
+	if p.victimPinner != nil {
+		p.victimPinner.Unpin()
+		p.pinnedCount.Add(-p.victimPinnedCount)
+	}
+	p.victimPinner = p.pinner
+	p.victimPinnedCount = int64(len(pinnedItems))
+	p.victim = pinnedItems
+	p.victimSet = pinnedSet
+	p.victimMu.Unlock()
+
+	p.pinner = &runtime.Pinner{}
 }
@@ -1,8 +1,11 @@
 package staticsyncpool
 
 import (
+	"context"
+	"errors"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -13,6 +16,25 @@ type Example struct {
 	C float64
 }
 
+// newExamplePool builds a `Pool[*Example]` with a `newFunc`/`resetFunc` pair that zeroes every
+// field of `Example` on reset, applying opts on top. This is the fixture shared by every test
+// below that doesn't need a distinguishable reset value of its own.
+func newExamplePool(t *testing.T, opts ...Option) *Pool[*Example] {
+	t.Helper()
+
+	return New[Example](
+		func() *Example {
+			return &Example{}
+		},
+		func(es *Example) {
+			es.A = ""
+			es.B = 0
+			es.C = 0.0
+		},
+		opts...,
+	)
+}
+
 func TestPoolALotToSeeIfAnythingHappens(t *testing.T) {
 	for i := 0; i < 1000; i++ {
 		testPool(t, i)
@@ -121,3 +143,400 @@ func testPool(t *testing.T, iteration int) {
 
 	pool.Reset()
 }
+
+func TestPoolStrictSizeIsHardCapped(t *testing.T) {
+	const staticSize = 10
+	const maxSize = 15
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+	)
+
+	if cap(pool.strictCh) != maxSize {
+		t.Fatalf("expected strictCh capacity to be %d, got %d", maxSize, cap(pool.strictCh))
+	}
+
+	if len(pool.strictCh) != staticSize {
+		t.Fatalf("expected strictCh to be pre-filled with %d items, got %d", staticSize, len(pool.strictCh))
+	}
+
+	// Drain and return more than maxSize items; the channel should never hold more than maxSize.
+	items := make([]*Example, 0, maxSize+5)
+	for i := 0; i < maxSize+5; i++ {
+		items = append(items, pool.Get())
+	}
+
+	for _, item := range items {
+		pool.Put(item)
+	}
+
+	if len(pool.strictCh) > maxSize {
+		t.Fatalf("expected strictCh to never exceed %d items, got %d", maxSize, len(pool.strictCh))
+	}
+
+	pool.Reset()
+	pool.Close()
+}
+
+func TestPoolVictimCacheSurvivesReset(t *testing.T) {
+	const staticSize = 50
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithVictimCache(true),
+	)
+
+	pool.Reset()
+	runtime.GC()
+	runtime.GC()
+	runtime.GC()
+
+	// Without a victim cache, a burst of Get()s immediately after Reset() would all miss and
+	// allocate via newFunc. With the victim cache, they should be served from the victim
+	// generation instead.
+	for i := 0; i < staticSize; i++ {
+		pool.Get()
+	}
+
+	if len(pool.victim) != 0 {
+		t.Fatalf("expected victim generation to be fully drained, got %d items remaining", len(pool.victim))
+	}
+
+	pool.Drain()
+	if pool.victimPinner != nil {
+		t.Fatalf("expected victimPinner to be nil after Drain()")
+	}
+}
+
+func TestPoolVictimCacheDoesNotDoublePinOnInternalPoolHit(t *testing.T) {
+	const staticSize = 5
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithVictimCache(true),
+	)
+
+	// No GC in between: internalPool still holds the pre-Reset eager-filled items, so these Get()
+	// calls are expected to hit them rather than allocate fresh ones via newFunc. Each hit item is
+	// already pinned via the now-demoted victimPinner, so it must not be pinned again via the new
+	// generation's pinner.
+	pool.Reset()
+	for i := 0; i < staticSize; i++ {
+		pool.Get()
+	}
+
+	if pinned := pool.Stats().PinnedCount; pinned != staticSize {
+		t.Fatalf("expected PinnedCount to stay at %d after Reset() with no GC in between, got %d", staticSize, pinned)
+	}
+}
+
+func TestPoolStatsAndObserver(t *testing.T) {
+	const staticSize = 5
+	var resetEvents int
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithObserver(func(event PoolEvent) {
+			if event.Kind == PoolEventReset {
+				resetEvents++
+			}
+		}),
+	)
+
+	stats := pool.Stats()
+	if stats.PinnedCount != staticSize {
+		t.Fatalf("expected PinnedCount to be %d after eager fill, got %d", staticSize, stats.PinnedCount)
+	}
+
+	item := pool.Get()
+	pool.Put(item)
+
+	stats = pool.Stats()
+	if stats.Gets != 1 || stats.Puts != 1 {
+		t.Fatalf("expected Gets=1 Puts=1, got Gets=%d Puts=%d", stats.Gets, stats.Puts)
+	}
+
+	pool.Reset()
+
+	if resetEvents != 1 {
+		t.Fatalf("expected observer to see exactly 1 reset event, got %d", resetEvents)
+	}
+
+	if pool.Stats().Resets != 1 {
+		t.Fatalf("expected Resets counter to be 1, got %d", pool.Stats().Resets)
+	}
+}
+
+func TestPoolStrictSizePinnedCountStaysBounded(t *testing.T) {
+	const staticSize = 5
+	const maxSize = 5
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+	)
+
+	if pool.Stats().PinnedCount != staticSize {
+		t.Fatalf("expected PinnedCount to be %d after eager fill, got %d", staticSize, pool.Stats().PinnedCount)
+	}
+
+	// Cycling the same items through Get/Put repeatedly must not re-pin them: PinnedCount
+	// should stay at staticSize no matter how many cycles run.
+	for i := 0; i < 10_000; i++ {
+		item := pool.Get()
+		pool.Put(item)
+	}
+
+	if pinned := pool.Stats().PinnedCount; pinned != staticSize {
+		t.Fatalf("expected PinnedCount to stay at %d after repeated Get/Put cycles, got %d", staticSize, pinned)
+	}
+
+	pool.Reset()
+	pool.Close()
+}
+
+func TestPoolStrictSizeIgnoresVictimCache(t *testing.T) {
+	const staticSize = 2
+	const maxSize = 4
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+		WithVictimCache(true),
+	)
+
+	// WithVictimCache(true) has no effect when combined with WithStrictSize(true) (see
+	// WithVictimCache): an item drained from the victim generation would otherwise carry no
+	// record of already being pinned, so a later Put would re-pin it via the current
+	// generation's pinner and blow through the hard maxSize cap.
+	for i := 0; i < 5; i++ {
+		item := pool.Get()
+		item2 := pool.Get()
+		pool.Reset()
+		pool.Put(item)
+		pool.Put(item2)
+
+		if pinned := pool.Stats().PinnedCount; pinned > int64(maxSize) {
+			t.Fatalf("expected PinnedCount to never exceed %d, got %d", maxSize, pinned)
+		}
+	}
+
+	pool.Close()
+}
+
+func TestPoolShardedDistributesPinning(t *testing.T) {
+	const staticSize = 40
+	const shardCount = 4
+	pool := newExamplePool(t,
+		WithLazy(true),
+		WithStaticSize(staticSize),
+		WithSharded(true),
+		WithShardCount(shardCount),
+	)
+
+	if len(pool.shards) != shardCount {
+		t.Fatalf("expected %d shards, got %d", shardCount, len(pool.shards))
+	}
+
+	items := make([]*Example, 0, staticSize)
+	for i := 0; i < staticSize; i++ {
+		items = append(items, pool.Get())
+	}
+
+	for _, item := range items {
+		pool.Put(item)
+	}
+
+	var total int64
+	for _, sh := range pool.shards {
+		total += sh.lazySize.Load()
+	}
+
+	if total == 0 {
+		t.Fatalf("expected shards to have pinned some items, got 0 across all shards")
+	}
+
+	if pool.Stats().PinnedCount != total {
+		t.Fatalf("expected PinnedCount (%d) to match the sum across shards (%d)", pool.Stats().PinnedCount, total)
+	}
+
+	pool.Reset()
+}
+
+func TestPoolShardedNotifiesFirstFillComplete(t *testing.T) {
+	const staticSize = 40
+	const shardCount = 4
+	var firstFillEvents int
+	pool := newExamplePool(t,
+		WithLazy(true),
+		WithStaticSize(staticSize),
+		WithSharded(true),
+		WithShardCount(shardCount),
+		WithObserver(func(event PoolEvent) {
+			if event.Kind == PoolEventFirstFillComplete {
+				firstFillEvents++
+			}
+		}),
+	)
+
+	items := make([]*Example, 0, staticSize)
+	for i := 0; i < staticSize; i++ {
+		items = append(items, pool.Get())
+	}
+
+	if pool.Stats().PinnedCount != int64(staticSize) {
+		t.Fatalf("expected PinnedCount to be %d, got %d", staticSize, pool.Stats().PinnedCount)
+	}
+
+	if firstFillEvents != 1 {
+		t.Fatalf("expected observer to see exactly 1 FirstFillComplete event, got %d", firstFillEvents)
+	}
+
+	for _, item := range items {
+		pool.Put(item)
+	}
+
+	pool.Reset()
+}
+
+func TestPoolShardedStaticSizeSmallerThanShardCountStaysBounded(t *testing.T) {
+	const staticSize = 3
+	const shardCount = 16
+	pool := newExamplePool(t,
+		WithLazy(true),
+		WithStaticSize(staticSize),
+		WithSharded(true),
+		WithShardCount(shardCount),
+	)
+
+	// With more shards than StaticSize, most shards' target is 0: they must never pin, rather
+	// than every shard flooring up to at least 1 and collectively overshooting StaticSize.
+	items := make([]*Example, 0, shardCount)
+	for i := 0; i < shardCount; i++ {
+		items = append(items, pool.Get())
+	}
+
+	for _, item := range items {
+		pool.Put(item)
+	}
+
+	if pinned := pool.Stats().PinnedCount; pinned > staticSize {
+		t.Fatalf("expected PinnedCount to never exceed StaticSize (%d), got %d", staticSize, pinned)
+	}
+
+	pool.Reset()
+}
+
+func TestPoolGetContextGrowsTowardMaxSize(t *testing.T) {
+	const staticSize = 1
+	const maxSize = 3
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+	)
+
+	// The channel only starts with staticSize items, but GetContext should grow the live count
+	// up to maxSize by allocating instead of blocking on the first empty-channel miss.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	items := make([]*Example, 0, maxSize)
+	for i := 0; i < maxSize; i++ {
+		item, err := pool.GetContext(ctx)
+		if err != nil {
+			t.Fatalf("expected GetContext to grow up to maxSize without blocking, got err=%v on item %d", err, i)
+		}
+		items = append(items, item)
+	}
+
+	if pinned := pool.Stats().PinnedCount; pinned != maxSize {
+		t.Fatalf("expected PinnedCount to reach maxSize (%d) after growing, got %d", maxSize, pinned)
+	}
+
+	// A further acquire beyond maxSize must still block/time out rather than growing further.
+	if _, err := pool.GetContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected GetContext to stop growing at maxSize and time out, got err=%v", err)
+	}
+
+	for _, item := range items {
+		pool.Put(item)
+	}
+
+	pool.Reset()
+	pool.Close()
+}
+
+func TestPoolGetContextWaitsThenSucceeds(t *testing.T) {
+	const staticSize = 1
+	const maxSize = 1
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+	)
+
+	item := pool.Get()
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := pool.GetContext(ctx)
+		done <- err
+	}()
+
+	// Give the goroutine above a chance to block on the empty strictCh before we Put.
+	time.Sleep(10 * time.Millisecond)
+	pool.Put(item)
+
+	if err := <-done; err != nil {
+		t.Fatalf("expected GetContext to succeed once an item was Put, got err=%v", err)
+	}
+
+	pool.Reset()
+	pool.Close()
+}
+
+func TestPoolGetContextRejectsOnAcquireTimeout(t *testing.T) {
+	const staticSize = 1
+	const maxSize = 1
+	var rejections int
+	pool := newExamplePool(t,
+		WithLazy(false),
+		WithStaticSize(staticSize),
+		WithStrictSize(true),
+		WithMaxSize(maxSize),
+		WithAcquireTimeout(10*time.Millisecond),
+		WithObserver(func(event PoolEvent) {
+			if event.Kind == PoolEventRejection {
+				rejections++
+			}
+		}),
+	)
+
+	// Drain the only item out of the pool; GetContext has nothing to offer and no one will Put.
+	pool.Get()
+
+	_, err := pool.GetContext(context.Background())
+	if !errors.Is(err, ErrPoolExhausted) {
+		t.Fatalf("expected ErrPoolExhausted, got %v", err)
+	}
+
+	if pool.Stats().Rejections != 1 {
+		t.Fatalf("expected Rejections to be 1, got %d", pool.Stats().Rejections)
+	}
+
+	if rejections != 1 {
+		t.Fatalf("expected observer to see exactly 1 rejection event, got %d", rejections)
+	}
+
+	pool.Reset()
+	pool.Close()
+}
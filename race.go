@@ -0,0 +1,9 @@
+//go:build race
+
+package staticsyncpool
+
+// RaceEnabled reports whether the binary was built with the race detector (`go test -race` /
+// `go build -race`). Tests that exercise `sync.Pool`'s own racy internals (see the comment on
+// `testPool`) use this to skip themselves under the race detector instead of asserting on
+// behavior `sync.Pool` doesn't guarantee there.
+const RaceEnabled = true
@@ -0,0 +1,59 @@
+package staticsyncpool
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// shard holds one shard's worth of the per-P sharded pinning state used when the pool is
+// configured with `WithSharded(true)`. Each shard has its own pinner and lazy-fill counter, so
+// concurrent `Get` calls only contend with the ~1/len(shards) of calls that land on the same
+// shard, instead of all of them serializing through one shared pinner and counter.
+//
+// Go's runtime doesn't expose a public API for discovering which P the calling goroutine is
+// currently bound to (that's exactly the kind of internal plumbing sync.Pool itself uses, via
+// runtime-private hooks this package intentionally doesn't link against to stay portable across
+// Go versions). Shard selection here is therefore a simple atomic round-robin over
+// `runtime.GOMAXPROCS(0)` shards rather than true per-P affinity: it still spreads pinning
+// bookkeeping across independent counters/pinners, which is the actual source of contention,
+// without requiring a goroutine to consistently land on the same shard.
+type shard struct {
+	pinner   runtime.Pinner
+	lazySize atomic.Int64
+	// target is this shard's share of the pool's configured static size, assigned once at
+	// shard creation (see newShards) so that the sum of every shard's target is exactly
+	// `StaticSize`, rather than every shard independently flooring up to at least 1.
+	target int64
+}
+
+// newShards builds n shards, defaulting n to runtime.GOMAXPROCS(0) when n is 0 or less, and
+// distributes staticSize across them as evenly as possible: staticSize/n goes to every shard,
+// with the staticSize%n remainder handed out one-per-shard to the first shards, so the shards'
+// targets always sum to exactly staticSize instead of collectively overshooting it when
+// staticSize is smaller than n.
+func newShards(n, staticSize int) []*shard {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+
+	base := int64(staticSize / n)
+	remainder := staticSize % n
+
+	shards := make([]*shard, n)
+	for i := range shards {
+		target := base
+		if i < remainder {
+			target++
+		}
+
+		shards[i] = &shard{target: target}
+	}
+
+	return shards
+}
+
+// shardFor picks the next shard via an atomic round-robin counter.
+func (p *Pool[T]) shardFor() *shard {
+	idx := p.shardCursor.Add(1) % uint64(len(p.shards))
+	return p.shards[idx]
+}
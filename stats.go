@@ -0,0 +1,74 @@
+package staticsyncpool
+
+// PoolStats is a point-in-time snapshot of a Pool[T]'s runtime counters, as returned by
+// `Stats()`. All counters except `PinnedCount` are cumulative for the lifetime of the Pool.
+type PoolStats struct {
+	// Gets is the number of times `Get` or `GetContext` has been called.
+	Gets int64
+	// Puts is the number of times `Put` has been called.
+	Puts int64
+	// News is the number of times `Get` had nothing to offer and called `newFunc` directly.
+	News int64
+	// PinnedCount is the number of items currently pinned and unreclaimable by GC, across
+	// both the current and (if `WithVictimCache(true)` is configured) the victim generation.
+	PinnedCount int64
+	// Resets is the number of times `Reset` has been called.
+	Resets int64
+	// Overflows is the number of times `Put`, under `WithStrictSize(true)`, found the bounded
+	// channel full and fell back to `internalPool.Put`.
+	Overflows int64
+	// Rejections is the number of times `GetContext` gave up waiting for an item under
+	// strict-capacity mode, either because the context was cancelled or the acquire timeout
+	// elapsed. Always 0 unless `GetContext` is used.
+	Rejections int64
+}
+
+// PoolEventKind identifies the kind of lifecycle event delivered to an observer registered via
+// `WithObserver`.
+type PoolEventKind int
+
+const (
+	// PoolEventReset fires every time Reset() completes.
+	PoolEventReset PoolEventKind = iota
+	// PoolEventOverflow fires when Put, under WithStrictSize(true), finds the bounded channel
+	// full and falls back to internalPool.Put.
+	PoolEventOverflow
+	// PoolEventFirstFillComplete fires the first time the pool reaches its configured
+	// StaticSize worth of pinned items, whether that happens eagerly at construction time
+	// (non-lazy) or incrementally as Get is called (lazy), and again each time the pool refills
+	// after a Reset(). A lazy pool configured with WithStrictSize(true) never fires it.
+	PoolEventFirstFillComplete
+	// PoolEventRejection fires when GetContext gives up waiting for an item under
+	// strict-capacity mode.
+	PoolEventRejection
+)
+
+// PoolEvent is delivered to the observer function registered via `WithObserver` whenever a pool
+// lifecycle event occurs. Unlike the counters in `PoolStats`, which can be read at any time via
+// `Stats()`, events are pushed only for the handful of lifecycle transitions above - never on
+// every `Get`/`Put` - so that registering an observer doesn't cost anything on the hot path.
+type PoolEvent struct {
+	Kind PoolEventKind
+}
+
+// notify invokes the configured observer, if any, with the given event.
+func (p *Pool[T]) notify(event PoolEvent) {
+	if p.config.observer == nil {
+		return
+	}
+
+	p.config.observer(event)
+}
+
+// Stats returns a snapshot of the pool's runtime counters.
+func (p *Pool[T]) Stats() PoolStats {
+	return PoolStats{
+		Gets:        p.gets.Load(),
+		Puts:        p.puts.Load(),
+		News:        p.news.Load(),
+		PinnedCount: p.pinnedCount.Load(),
+		Resets:      p.resets.Load(),
+		Overflows:   p.overflows.Load(),
+		Rejections:  p.rejections.Load(),
+	}
+}